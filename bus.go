@@ -0,0 +1,61 @@
+package adxl345
+
+import "sync"
+
+// Bus is the minimal transport the ADXL345 driver needs: single- or
+// multi-byte register reads, single-byte register writes, and a way
+// to release the underlying handle. Any I2C or SPI transport can be
+// adapted to it; see smbus.go, periph.go, xi2c.go and spi.go for the
+// bundled adapters.
+type Bus interface {
+	// ReadReg reads len(p) bytes starting at reg into p.
+	ReadReg(reg byte, p []byte) error
+	// WriteReg writes val to reg.
+	WriteReg(reg, val byte) error
+	// Close releases the underlying handle.
+	Close() error
+}
+
+// lockedBus serializes access to an underlying Bus with a mutex. A
+// register read or write is a write-pointer-then-read/write sequence
+// on real I2C/SPI hardware; two goroutines issuing one concurrently
+// (e.g. a Reader's sample and interrupt pollers) can interleave and
+// return corrupted data. ADXL345 always wraps its bus in one so a
+// single device is safe to drive from multiple goroutines.
+type lockedBus struct {
+	mu  sync.Mutex
+	bus Bus
+}
+
+// newLockedBus wraps bus so ReadReg, WriteReg and Close are
+// serialized across callers.
+func newLockedBus(bus Bus) Bus {
+	return &lockedBus{bus: bus}
+}
+
+func (b *lockedBus) ReadReg(reg byte, p []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.bus.ReadReg(reg, p)
+}
+
+func (b *lockedBus) WriteReg(reg, val byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.bus.WriteReg(reg, val)
+}
+
+func (b *lockedBus) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.bus.Close()
+}
+
+// readByte reads a single register through bus.
+func readByte(bus Bus, reg byte) (byte, error) {
+	buf := make([]byte, 1)
+	if err := bus.ReadReg(reg, buf); err != nil {
+		return 0, err
+	}
+	return buf[0], nil
+}
@@ -0,0 +1,115 @@
+package adxl345
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Offset trim registers
+const (
+	ofsX = 0x1E
+	ofsY = 0x1F
+	ofsZ = 0x20
+)
+
+// offsetResolution is the fixed 15.6 mg/LSB scale of OFSX/OFSY/OFSZ,
+// independent of the configured measurement range.
+const offsetResolution = 0.0156
+
+// SetOffsets writes the OFSX, OFSY and OFSZ trim registers. Each
+// value is in units of 15.6 mg/LSB, per the datasheet.
+func (a ADXL345) SetOffsets(x, y, z int8) error {
+	if err := a.bus.WriteReg(ofsX, byte(x)); err != nil {
+		return err
+	}
+	if err := a.bus.WriteReg(ofsY, byte(y)); err != nil {
+		return err
+	}
+	return a.bus.WriteReg(ofsZ, byte(z))
+}
+
+// GetOffsets reads back the OFSX, OFSY and OFSZ trim registers.
+func (a ADXL345) GetOffsets() (x, y, z int8, err error) {
+	xv, err := readByte(a.bus, ofsX)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	yv, err := readByte(a.bus, ofsY)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	zv, err := readByte(a.bus, ofsZ)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return int8(xv), int8(yv), int8(zv), nil
+}
+
+// Calibrate averages samples readings taken with the sensor
+// stationary on a level surface (Z axis facing up, reading +1g),
+// computes the per-axis correction and writes it to OFSX/OFSY/OFSZ.
+func (a ADXL345) Calibrate(samples int) error {
+	if samples <= 0 {
+		return fmt.Errorf("adxl345: Calibrate requires a positive sample count, got %d", samples)
+	}
+
+	var sum Vector
+	for i := 0; i < samples; i++ {
+		axes, err := a.GetAxesG()
+		if err != nil {
+			return err
+		}
+		sum.X += axes.X
+		sum.Y += axes.Y
+		sum.Z += axes.Z
+	}
+
+	mean := Vector{
+		X: sum.X / float64(samples),
+		Y: sum.Y / float64(samples),
+		Z: sum.Z / float64(samples),
+	}
+
+	x, y, z, err := a.GetOffsets()
+	if err != nil {
+		return err
+	}
+
+	x -= int8(round(mean.X/offsetResolution, 0))
+	y -= int8(round(mean.Y/offsetResolution, 0))
+	z -= int8(round((mean.Z-1)/offsetResolution, 0))
+
+	return a.SetOffsets(x, y, z)
+}
+
+// calibrationFile is the JSON document written by SaveCalibration and
+// read by LoadCalibration.
+type calibrationFile struct {
+	X int8 `json:"x"`
+	Y int8 `json:"y"`
+	Z int8 `json:"z"`
+}
+
+// SaveCalibration writes the current OFSX/OFSY/OFSZ trim as JSON to
+// w, so it can be restored with LoadCalibration after a restart.
+func (a ADXL345) SaveCalibration(w io.Writer) error {
+	x, y, z, err := a.GetOffsets()
+	if err != nil {
+		return err
+	}
+
+	return json.NewEncoder(w).Encode(calibrationFile{X: x, Y: y, Z: z})
+}
+
+// LoadCalibration reads a JSON document written by SaveCalibration
+// from r and applies it via SetOffsets.
+func (a ADXL345) LoadCalibration(r io.Reader) error {
+	var cal calibrationFile
+	if err := json.NewDecoder(r).Decode(&cal); err != nil {
+		return err
+	}
+
+	return a.SetOffsets(cal.X, cal.Y, cal.Z)
+}
@@ -0,0 +1,84 @@
+package adxl345
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSetGetOffsets(t *testing.T) {
+	bus := newFakeBus()
+	a, err := NewADXL345WithBus(bus, AddressDef)
+	if err != nil {
+		t.Fatalf("NewADXL345WithBus returned error: %v", err)
+	}
+
+	if err := a.SetOffsets(-5, 3, 127); err != nil {
+		t.Fatalf("SetOffsets returned error: %v", err)
+	}
+
+	x, y, z, err := a.GetOffsets()
+	if err != nil {
+		t.Fatalf("GetOffsets returned error: %v", err)
+	}
+	if x != -5 || y != 3 || z != 127 {
+		t.Errorf("GetOffsets() = (%d, %d, %d), want (-5, 3, 127)", x, y, z)
+	}
+}
+
+func TestCalibrateCorrectsStationaryReading(t *testing.T) {
+	bus := newFakeBus()
+	a, err := NewADXL345WithBus(bus, AddressDef)
+	if err != nil {
+		t.Fatalf("NewADXL345WithBus returned error: %v", err)
+	}
+
+	// Z reads +1g already (256 LSB at full-res), X and Y read 0: a
+	// level, stationary sensor, so Calibrate should leave the offsets
+	// at zero.
+	bus.regs[dataZ1] = 0x01
+
+	if err := a.Calibrate(4); err != nil {
+		t.Fatalf("Calibrate returned error: %v", err)
+	}
+
+	x, y, z, err := a.GetOffsets()
+	if err != nil {
+		t.Fatalf("GetOffsets returned error: %v", err)
+	}
+	if x != 0 || y != 0 || z != 0 {
+		t.Errorf("GetOffsets() after Calibrate = (%d, %d, %d), want (0, 0, 0)", x, y, z)
+	}
+}
+
+func TestSaveLoadCalibration(t *testing.T) {
+	bus := newFakeBus()
+	a, err := NewADXL345WithBus(bus, AddressDef)
+	if err != nil {
+		t.Fatalf("NewADXL345WithBus returned error: %v", err)
+	}
+
+	if err := a.SetOffsets(1, -2, 3); err != nil {
+		t.Fatalf("SetOffsets returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := a.SaveCalibration(&buf); err != nil {
+		t.Fatalf("SaveCalibration returned error: %v", err)
+	}
+
+	if err := a.SetOffsets(0, 0, 0); err != nil {
+		t.Fatalf("SetOffsets returned error: %v", err)
+	}
+
+	if err := a.LoadCalibration(&buf); err != nil {
+		t.Fatalf("LoadCalibration returned error: %v", err)
+	}
+
+	x, y, z, err := a.GetOffsets()
+	if err != nil {
+		t.Fatalf("GetOffsets returned error: %v", err)
+	}
+	if x != 1 || y != -2 || z != 3 {
+		t.Errorf("GetOffsets() after LoadCalibration = (%d, %d, %d), want (1, -2, 3)", x, y, z)
+	}
+}
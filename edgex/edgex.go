@@ -0,0 +1,169 @@
+// Package edgex implements an EdgeX device-sdk-go v2 style
+// ProtocolDriver for the ADXL345, so the sensor can be dropped into
+// an EdgeX deployment the same way device-modbus wraps a Modbus
+// driver.
+//
+// It deliberately does not import github.com/edgexfoundry/device-sdk-go/v2:
+// that SDK's transitive dependencies (Consul, gRPC, MQTT, and a cgo
+// binding to libzmq) are far heavier than a single sensor driver
+// warrants, and the cgo dependency doesn't build everywhere a pure-Go
+// I2C driver otherwise would. ProtocolDriver, ProtocolProperties,
+// CommandRequest and CommandValue below mirror the shapes the real
+// SDK expects closely enough that wiring this Driver into
+// device-sdk-go's ProtocolDriver slot is a thin adapter, not a
+// rewrite.
+package edgex
+
+import (
+	"fmt"
+	"time"
+
+	adxl345 "github.com/Devligue/go-adxl345"
+	"github.com/Devligue/go-adxl345/internal/reader"
+)
+
+// Resource names exposed through device profile commands.
+const (
+	ResourceAccelerationX = "AccelerationX"
+	ResourceAccelerationY = "AccelerationY"
+	ResourceAccelerationZ = "AccelerationZ"
+)
+
+// ProtocolProperties holds a device's protocol-specific configuration,
+// as found in an EdgeX device profile's protocols section.
+type ProtocolProperties map[string]string
+
+// AdminState mirrors EdgeX's device admin state (locked/unlocked).
+type AdminState string
+
+// CommandRequest identifies one resource being read or written, as
+// EdgeX's command service issues it.
+type CommandRequest struct {
+	DeviceResourceName string
+	Type               string
+}
+
+// CommandValue is a single named reading or write payload.
+type CommandValue struct {
+	DeviceResourceName string
+	Type               string
+	Value              float64
+}
+
+// ProtocolDriver mirrors the subset of EdgeX device-sdk-go v2's
+// interfaces.ProtocolDriver that this driver implements.
+type ProtocolDriver interface {
+	AddDevice(deviceName string, protocols map[string]ProtocolProperties, adminState AdminState) error
+	UpdateDevice(deviceName string, protocols map[string]ProtocolProperties, adminState AdminState) error
+	RemoveDevice(deviceName string, protocols map[string]ProtocolProperties) error
+	HandleReadCommands(deviceName string, protocols map[string]ProtocolProperties, reqs []CommandRequest) ([]*CommandValue, error)
+	HandleWriteCommands(deviceName string, protocols map[string]ProtocolProperties, reqs []CommandRequest, params []*CommandValue) error
+	Stop(force bool) error
+}
+
+// Driver implements ProtocolDriver, keeping one reader per configured
+// device.
+type Driver struct {
+	readers map[string]*reader.Reader
+}
+
+// NewDriver creates an empty Driver. Devices are attached through
+// AddDevice as the EdgeX device service discovers or is configured
+// with them.
+func NewDriver() *Driver {
+	return &Driver{readers: make(map[string]*reader.Reader)}
+}
+
+// AddDevice opens the ADXL345 named by deviceName over I2C using the
+// interface index and address given in its protocol properties, and
+// attaches a reader.Reader for it.
+func (d *Driver) AddDevice(deviceName string, protocols map[string]ProtocolProperties, adminState AdminState) error {
+	props := protocols["I2C"]
+
+	var interfaceIdx uint
+	var address byte
+	if _, err := fmt.Sscanf(props["InterfaceIdx"], "%d", &interfaceIdx); err != nil {
+		return err
+	}
+	if _, err := fmt.Sscanf(props["Address"], "0x%x", &address); err != nil {
+		return err
+	}
+
+	device, err := adxl345.NewADXL345(interfaceIdx, address)
+	if err != nil {
+		return err
+	}
+
+	d.readers[deviceName] = reader.New(device, 100*time.Millisecond)
+	return nil
+}
+
+// UpdateDevice re-attaches the device, identical to AddDevice.
+func (d *Driver) UpdateDevice(deviceName string, protocols map[string]ProtocolProperties, adminState AdminState) error {
+	return d.AddDevice(deviceName, protocols, adminState)
+}
+
+// RemoveDevice closes and forgets the reader for deviceName.
+func (d *Driver) RemoveDevice(deviceName string, protocols map[string]ProtocolProperties) error {
+	if r, ok := d.readers[deviceName]; ok {
+		r.Device.Close()
+		delete(d.readers, deviceName)
+	}
+	return nil
+}
+
+// HandleReadCommands samples the device once per call and returns
+// each requested axis from that sample as a float64 CommandValue.
+func (d *Driver) HandleReadCommands(deviceName string, protocols map[string]ProtocolProperties, reqs []CommandRequest) ([]*CommandValue, error) {
+	r, ok := d.readers[deviceName]
+	if !ok {
+		return nil, fmt.Errorf("edgex: unknown device %q", deviceName)
+	}
+
+	axes, err := r.Sample()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*CommandValue, len(reqs))
+	for i, req := range reqs {
+		var value float64
+		switch req.DeviceResourceName {
+		case ResourceAccelerationX:
+			value = axes.X
+		case ResourceAccelerationY:
+			value = axes.Y
+		case ResourceAccelerationZ:
+			value = axes.Z
+		default:
+			return nil, fmt.Errorf("edgex: unsupported resource %q", req.DeviceResourceName)
+		}
+
+		res[i] = &CommandValue{DeviceResourceName: req.DeviceResourceName, Type: req.Type, Value: value}
+	}
+
+	return res, nil
+}
+
+// HandleWriteCommands is unsupported: the ADXL345's acceleration
+// resources are read-only.
+func (d *Driver) HandleWriteCommands(deviceName string, protocols map[string]ProtocolProperties, reqs []CommandRequest, params []*CommandValue) error {
+	return fmt.Errorf("edgex: ADXL345 resources are read-only")
+}
+
+// Stop closes every attached device.
+func (d *Driver) Stop(force bool) error {
+	for name, r := range d.readers {
+		r.Device.Close()
+		delete(d.readers, name)
+	}
+	return nil
+}
+
+// Discover is unsupported: the ADXL345 has no self-describing
+// discovery protocol over I2C.
+func (d *Driver) Discover() error {
+	return fmt.Errorf("edgex: discovery is not supported")
+}
+
+var _ ProtocolDriver = (*Driver)(nil)
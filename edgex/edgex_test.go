@@ -0,0 +1,52 @@
+package edgex
+
+import (
+	"testing"
+	"time"
+
+	adxl345 "github.com/Devligue/go-adxl345"
+	"github.com/Devligue/go-adxl345/internal/reader"
+	"github.com/Devligue/go-adxl345/internal/testbus"
+)
+
+func TestHandleReadCommands(t *testing.T) {
+	bus := testbus.New()
+	bus.Regs[testbus.DataX1] = 0x01 // ~1g on X at full-res scale
+
+	device, err := adxl345.NewADXL345WithBus(bus, adxl345.AddressDef)
+	if err != nil {
+		t.Fatalf("NewADXL345WithBus returned error: %v", err)
+	}
+
+	d := NewDriver()
+	d.readers["sensor0"] = reader.New(device, time.Millisecond)
+
+	res, err := d.HandleReadCommands("sensor0", nil, []CommandRequest{
+		{DeviceResourceName: ResourceAccelerationX, Type: "Float64"},
+	})
+	if err != nil {
+		t.Fatalf("HandleReadCommands returned error: %v", err)
+	}
+	if len(res) != 1 {
+		t.Fatalf("len(res) = %d, want 1", len(res))
+	}
+	if res[0].Value <= 0 {
+		t.Errorf("res[0].Value = %v, want > 0", res[0].Value)
+	}
+}
+
+func TestHandleReadCommandsUnknownDevice(t *testing.T) {
+	d := NewDriver()
+
+	if _, err := d.HandleReadCommands("missing", nil, []CommandRequest{{DeviceResourceName: ResourceAccelerationX}}); err == nil {
+		t.Fatal("HandleReadCommands with an unknown device expected an error, got nil")
+	}
+}
+
+func TestHandleWriteCommandsUnsupported(t *testing.T) {
+	d := NewDriver()
+
+	if err := d.HandleWriteCommands("sensor0", nil, nil, nil); err == nil {
+		t.Fatal("HandleWriteCommands expected an error since ADXL345 resources are read-only, got nil")
+	}
+}
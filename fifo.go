@@ -0,0 +1,174 @@
+package adxl345
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// FIFO registers
+const (
+	fifoCTL    = 0x38
+	fifoStatus = 0x39
+)
+
+// FIFOMode selects the behaviour of the 32-sample hardware FIFO, as
+// encoded in the top two bits of FIFO_CTL.
+type FIFOMode byte
+
+// Available FIFO modes
+const (
+	FIFOBypass  FIFOMode = 0x00
+	FIFOEnabled FIFOMode = 0x01
+	FIFOStream  FIFOMode = 0x02
+	FIFOTrigger FIFOMode = 0x03
+)
+
+// TriggerPin selects which INT pin is watched by trigger mode.
+type TriggerPin byte
+
+// Available trigger pins
+const (
+	TriggerInt1 TriggerPin = 0x00
+	TriggerInt2 TriggerPin = 0x01
+)
+
+// SetFIFOMode configures FIFO_CTL: the FIFO mode, the watermark
+// sample count (0-31) and, for FIFOTrigger mode, which INT pin acts
+// as the trigger.
+func (a ADXL345) SetFIFOMode(mode FIFOMode, samples uint8, trigger TriggerPin) error {
+	if samples > 31 {
+		return fmt.Errorf("adxl345: FIFO samples must be in range 0-31, got %d", samples)
+	}
+
+	value := byte(mode)<<6 | byte(trigger)<<5 | byte(samples)
+
+	return a.bus.WriteReg(fifoCTL, value)
+}
+
+// FIFOStatus reads FIFO_STATUS, returning the number of valid
+// entries currently stored in the FIFO and whether a trigger event
+// has occurred.
+func (a ADXL345) FIFOStatus() (entries uint8, triggered bool, err error) {
+	retval, err := readByte(a.bus, fifoStatus)
+	if err != nil {
+		return 0, false, err
+	}
+
+	entries = retval & 0x3F
+	triggered = retval&0x80 != 0
+
+	return entries, triggered, nil
+}
+
+// ReadFIFO drains up to len(buf) samples from the FIFO, reading each
+// entry with a block read of DATAX0-DATAZ1. It returns the number of
+// samples written into buf, which may be fewer than len(buf) if the
+// FIFO held fewer entries.
+func (a ADXL345) ReadFIFO(buf []Vector) (n int, err error) {
+	entries, _, err := a.FIFOStatus()
+	if err != nil {
+		return 0, err
+	}
+
+	want := int(entries)
+	if want > len(buf) {
+		want = len(buf)
+	}
+
+	raw := make([]byte, 6)
+	for n = 0; n < want; n++ {
+		if err = a.bus.ReadReg(dataX0, raw); err != nil {
+			return n, err
+		}
+
+		x := int16(raw[0]) | (int16(raw[1]) << 8)
+		y := int16(raw[2]) | (int16(raw[3]) << 8)
+		z := int16(raw[4]) | (int16(raw[5]) << 8)
+
+		buf[n] = Vector{
+			X: round(float64(x)*scaleMultiplier, 4),
+			Y: round(float64(y)*scaleMultiplier, 4),
+			Z: round(float64(z)*scaleMultiplier, 4),
+		}
+	}
+
+	return n, nil
+}
+
+// rateToInterval returns the sampling period for an ODR register
+// value as used with SetBandwidthRate.
+func rateToInterval(sampleRate byte) time.Duration {
+	hz := map[byte]float64{
+		Rate1600HZ: 1600,
+		Rate800HZ:  800,
+		Rate400HZ:  400,
+		Rate200HZ:  200,
+		Rate100HZ:  100,
+		Rate50HZ:   50,
+		Rate25HZ:   25,
+	}[sampleRate]
+
+	if hz == 0 {
+		hz = 100
+	}
+
+	return time.Duration(1e9/hz) * time.Nanosecond
+}
+
+// streamWatermark is the FIFO watermark StreamAxes configures and
+// drains on, in samples. Waking up once per watermark's worth of
+// sample periods, instead of once per sample, is what lets the FIFO
+// actually amortize the per-read bus transaction over many samples.
+const streamWatermark = 16
+
+// StreamAxes sets the device to the given sample rate, puts the FIFO
+// in stream mode and drains it roughly once every streamWatermark
+// sample periods, delivering each sample on the returned channel in
+// order. Both channels are closed once ctx is cancelled or a read
+// fails; at most one error is ever sent on the error channel.
+func (a ADXL345) StreamAxes(ctx context.Context, sampleRate byte) (<-chan Vector, <-chan error) {
+	samples := make(chan Vector)
+	errc := make(chan error, 1)
+
+	go func() {
+		defer close(samples)
+		defer close(errc)
+
+		if err := a.SetBandwidthRate(sampleRate); err != nil {
+			errc <- err
+			return
+		}
+		if err := a.SetFIFOMode(FIFOStream, streamWatermark, TriggerInt1); err != nil {
+			errc <- err
+			return
+		}
+
+		ticker := time.NewTicker(rateToInterval(sampleRate) * streamWatermark)
+		defer ticker.Stop()
+
+		buf := make([]Vector, 32)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				n, err := a.ReadFIFO(buf)
+				if err != nil {
+					errc <- err
+					return
+				}
+
+				for i := 0; i < n; i++ {
+					select {
+					case samples <- buf[i]:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return samples, errc
+}
@@ -0,0 +1,43 @@
+package adxl345
+
+import "testing"
+
+func TestSetFIFOMode(t *testing.T) {
+	bus := newFakeBus()
+	a, err := NewADXL345WithBus(bus, AddressDef)
+	if err != nil {
+		t.Fatalf("NewADXL345WithBus returned error: %v", err)
+	}
+
+	if err := a.SetFIFOMode(FIFOStream, 16, TriggerInt2); err != nil {
+		t.Fatalf("SetFIFOMode returned error: %v", err)
+	}
+
+	want := byte(FIFOStream)<<6 | byte(TriggerInt2)<<5 | 16
+	if got := bus.regs[fifoCTL]; got != want {
+		t.Errorf("FIFO_CTL = 0x%02X, want 0x%02X", got, want)
+	}
+}
+
+func TestReadFIFODrainsReportedEntries(t *testing.T) {
+	bus := newFakeBus()
+	a, err := NewADXL345WithBus(bus, AddressDef)
+	if err != nil {
+		t.Fatalf("NewADXL345WithBus returned error: %v", err)
+	}
+
+	bus.regs[fifoStatus] = 3
+	bus.regs[dataX1] = 0x01 // 256 LSB ~= 1g on X
+
+	buf := make([]Vector, 8)
+	n, err := a.ReadFIFO(buf)
+	if err != nil {
+		t.Fatalf("ReadFIFO returned error: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("ReadFIFO() n = %d, want 3", n)
+	}
+	if want := round(256*scaleMultiplier, 4); buf[0].X != want {
+		t.Errorf("buf[0].X = %v, want %v", buf[0].X, want)
+	}
+}
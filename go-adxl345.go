@@ -4,8 +4,6 @@ package adxl345
 import (
 	"fmt"
 	"math"
-
-	"github.com/corrupt/go-smbus"
 )
 
 // Available I2C addresses
@@ -88,74 +86,85 @@ func (v Vector) Print() {
 // to set up connection with ADXL345 over I2C and read
 // measurement data.
 type ADXL345 struct {
-	bus          *smbus.SMBus
+	bus          Bus
 	Address      byte
 	InterfaceIdx uint
 }
 
-// NewADXL345 is a factory method creating instance of ADXL345,
-// setting base Bandwidth (100HZ), Range (2G) and enabling the
-// measurement
+// NewADXL345 is a factory method creating instance of ADXL345 over
+// corrupt/go-smbus, setting base Bandwidth (100HZ), Range (2G) and
+// enabling the measurement
 func NewADXL345(interfaceIdx uint, address byte) (ADXL345, error) {
-	smb, err := smbus.New(interfaceIdx, address)
-	adxl345 := ADXL345{
-		bus:          smb,
-		Address:      address,
-		InterfaceIdx: interfaceIdx,
-	}
+	bus, err := newSMBusBus(interfaceIdx, address)
 	if err != nil {
-		return adxl345, err
+		return ADXL345{Address: address, InterfaceIdx: interfaceIdx}, err
 	}
 
-	err = adxl345.SetBandwidthRate(Rate100HZ)
-	if err != nil {
+	adxl345, err := NewADXL345WithBus(bus, address)
+	adxl345.InterfaceIdx = interfaceIdx
+	return adxl345, err
+}
+
+// NewADXL345WithBus is a factory method creating an instance of
+// ADXL345 over an arbitrary Bus, setting base Bandwidth (100HZ),
+// Range (2G) and enabling the measurement. It is the entry point for
+// using the device over a transport other than the default
+// corrupt/go-smbus adapter, e.g. periph.io, golang.org/x/exp/io or
+// SPI. bus is wrapped so the returned ADXL345 is safe to drive
+// concurrently, e.g. sampling and reading interrupts from separate
+// goroutines at once.
+func NewADXL345WithBus(bus Bus, address byte) (ADXL345, error) {
+	adxl345 := ADXL345{
+		bus:     newLockedBus(bus),
+		Address: address,
+	}
+
+	if err := adxl345.SetBandwidthRate(Rate100HZ); err != nil {
 		return adxl345, err
 	}
 
-	err = adxl345.SetRange(Range2G)
-	if err != nil {
+	if err := adxl345.SetRange(Range2G); err != nil {
 		return adxl345, err
 	}
 
-	err = adxl345.EnableMeasurement()
-	if err != nil {
+	if err := adxl345.EnableMeasurement(); err != nil {
 		return adxl345, err
 	}
 
-	return adxl345, err
+	return adxl345, nil
 }
 
 // SetBandwidthRate changes the device bandwidth and output data rate.
 func (a ADXL345) SetBandwidthRate(newRate byte) error {
-	return a.bus.Write_byte_data(bwRate, newRate)
+	return a.bus.WriteReg(bwRate, newRate)
 }
 
 // SetRange changes the range of ADXL345. Available ranges are 2G,
 // 4G, 8G and 16G.
 func (a ADXL345) SetRange(newRange byte) error {
-	retval, err := a.bus.Read_byte_data(dataFormat)
+	retval, err := readByte(a.bus, dataFormat)
 	if err != nil {
 		return err
 	}
 
-	value := int32(retval)
-	value &= ^0x0F
-	value |= int32(newRange)
+	value := retval
+	value &= ^byte(0x0F)
+	value |= newRange
 	value |= 0x08
 
-	return a.bus.Write_byte_data(dataFormat, byte(value))
+	return a.bus.WriteReg(dataFormat, value)
 }
 
 // EnableMeasurement enables measurement on ADXL345
 func (a ADXL345) EnableMeasurement() error {
-	return a.bus.Write_byte_data(powerCTL, measure)
+	return a.bus.WriteReg(powerCTL, measure)
 }
 
 // GetAxesG retrives axes acceleration data from ADXL345. Values
 // are returned as multiplications of G
 func (a ADXL345) GetAxesG() (Vector, error) {
 	buf := make([]byte, 6)
-	_, err := a.bus.Read_i2c_block_data(dataX0, buf)
+	err := a.bus.ReadReg(dataX0, buf)
 	if err != nil {
 		axes := NewVector()
 		return axes, err
@@ -194,5 +203,5 @@ func (a ADXL345) GetAxesMS2() (Vector, error) {
 
 // Close disconnects from the device
 func (a ADXL345) Close() {
-	a.bus.Bus_close()
+	a.bus.Close()
 }
@@ -0,0 +1,124 @@
+// Package gobot implements a gobot.Driver for the ADXL345,
+// publishing acceleration samples and tap/free-fall/activity
+// interrupt events as Gobot events.
+package gobot
+
+import (
+	"context"
+	"time"
+
+	gobot "gobot.io/x/gobot/v2"
+
+	adxl345 "github.com/Devligue/go-adxl345"
+	"github.com/Devligue/go-adxl345/internal/reader"
+)
+
+// Gobot event names published by Driver.
+const (
+	Acceleration = "acceleration"
+	SingleTap    = "single_tap"
+	DoubleTap    = "double_tap"
+	FreeFall     = "free_fall"
+	Activity     = "activity"
+	Inactivity   = "inactivity"
+)
+
+// Driver is a gobot.Driver and gobot.Eventer wrapping an ADXL345.
+type Driver struct {
+	name       string
+	connection gobot.Connection
+	device     adxl345.ADXL345
+	interval   time.Duration
+	cancel     context.CancelFunc
+	gobot.Eventer
+}
+
+// NewDriver creates a Driver that samples device every interval and
+// publishes an Acceleration event for each reading.
+func NewDriver(device adxl345.ADXL345, interval time.Duration) *Driver {
+	d := &Driver{
+		name:     gobot.DefaultName("ADXL345"),
+		device:   device,
+		interval: interval,
+		Eventer:  gobot.NewEventer(),
+	}
+
+	d.AddEvent(Acceleration)
+	d.AddEvent(SingleTap)
+	d.AddEvent(DoubleTap)
+	d.AddEvent(FreeFall)
+	d.AddEvent(Activity)
+	d.AddEvent(Inactivity)
+
+	return d
+}
+
+// Name returns the driver's name.
+func (d *Driver) Name() string { return d.name }
+
+// SetName sets the driver's name.
+func (d *Driver) SetName(name string) { d.name = name }
+
+// Connection returns the driver's connection, required by the gobot.Driver interface.
+func (d *Driver) Connection() gobot.Connection { return d.connection }
+
+// Start begins sampling the device and forwarding interrupts, each on
+// its own goroutine, publishing Gobot events until Halt is called.
+func (d *Driver) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	d.cancel = cancel
+
+	r := reader.New(d.device, d.interval)
+	samples := r.Start(ctx)
+	events := r.Interrupts(ctx)
+
+	go func() {
+		for samples != nil || events != nil {
+			select {
+			case v, ok := <-samples:
+				if !ok {
+					samples = nil
+					continue
+				}
+				d.Publish(Acceleration, v)
+			case ev, ok := <-events:
+				if !ok {
+					events = nil
+					continue
+				}
+				d.publishInterrupt(ev)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// publishInterrupt fans a decoded interrupt event out to the
+// matching named Gobot event(s).
+func (d *Driver) publishInterrupt(ev adxl345.Event) {
+	if ev.Source&adxl345.IntSingleTap != 0 {
+		d.Publish(SingleTap, ev)
+	}
+	if ev.Source&adxl345.IntDoubleTap != 0 {
+		d.Publish(DoubleTap, ev)
+	}
+	if ev.Source&adxl345.IntFreeFall != 0 {
+		d.Publish(FreeFall, ev)
+	}
+	if ev.Source&adxl345.IntActive != 0 {
+		d.Publish(Activity, ev)
+	}
+	if ev.Source&adxl345.IntInactive != 0 {
+		d.Publish(Inactivity, ev)
+	}
+}
+
+// Halt stops sampling and releases the device.
+func (d *Driver) Halt() error {
+	if d.cancel != nil {
+		d.cancel()
+	}
+	d.device.Close()
+	return nil
+}
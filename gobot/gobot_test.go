@@ -0,0 +1,45 @@
+package gobot_test
+
+import (
+	"testing"
+	"time"
+
+	adxl345 "github.com/Devligue/go-adxl345"
+	adxlgobot "github.com/Devligue/go-adxl345/gobot"
+	"github.com/Devligue/go-adxl345/internal/testbus"
+)
+
+func TestDriverPublishesAcceleration(t *testing.T) {
+	bus := testbus.New()
+	bus.Regs[testbus.DataX1] = 0x01 // ~1g on X at full-res scale
+
+	device, err := adxl345.NewADXL345WithBus(bus, adxl345.AddressDef)
+	if err != nil {
+		t.Fatalf("NewADXL345WithBus returned error: %v", err)
+	}
+
+	driver := adxlgobot.NewDriver(device, time.Millisecond)
+	sub := driver.Subscribe()
+	defer driver.Unsubscribe(sub)
+
+	if err := driver.Start(); err != nil {
+		t.Fatalf("Start returned error: %v", err)
+	}
+	defer driver.Halt()
+
+	select {
+	case ev := <-sub:
+		if ev.Name != adxlgobot.Acceleration {
+			t.Errorf("event name = %q, want %q", ev.Name, adxlgobot.Acceleration)
+		}
+		v, ok := ev.Data.(adxl345.Vector)
+		if !ok {
+			t.Fatalf("event data type = %T, want adxl345.Vector", ev.Data)
+		}
+		if v.X <= 0 {
+			t.Errorf("event data X = %v, want > 0", v.X)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for an Acceleration event")
+	}
+}
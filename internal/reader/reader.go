@@ -0,0 +1,69 @@
+// Package reader implements periodic sampling of an ADXL345 device.
+// It is shared by the gobot and edgex integrations so the core
+// driver package stays framework-neutral.
+package reader
+
+import (
+	"context"
+	"time"
+
+	adxl345 "github.com/Devligue/go-adxl345"
+)
+
+// Reader polls an ADXL345 at a fixed interval, delivering each
+// acceleration sample and decoded interrupt event on its own channel.
+type Reader struct {
+	Device   adxl345.ADXL345
+	Interval time.Duration
+}
+
+// New creates a Reader for device, sampling every interval.
+func New(device adxl345.ADXL345, interval time.Duration) *Reader {
+	return &Reader{Device: device, Interval: interval}
+}
+
+// Sample reads a single acceleration vector from the device.
+func (r *Reader) Sample() (adxl345.Vector, error) {
+	return r.Device.GetAxesG()
+}
+
+// Start begins polling at r.Interval and returns a channel of
+// samples. Read errors are dropped; the channel is closed when ctx
+// is cancelled.
+func (r *Reader) Start(ctx context.Context) <-chan adxl345.Vector {
+	samples := make(chan adxl345.Vector)
+
+	go func() {
+		defer close(samples)
+
+		ticker := time.NewTicker(r.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				v, err := r.Sample()
+				if err != nil {
+					continue
+				}
+
+				select {
+				case samples <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return samples
+}
+
+// Interrupts forwards the device's interrupt events for the lifetime
+// of ctx, reusing ADXL345.Events with the Reader's own interval as
+// the poll period.
+func (r *Reader) Interrupts(ctx context.Context) <-chan adxl345.Event {
+	return r.Device.Events(ctx, r.Interval)
+}
@@ -0,0 +1,33 @@
+// Package testbus provides an in-memory adxl345.Bus for use from the
+// gobot and edgex packages' tests, mirroring the root package's own
+// unexported fakeBus test helper.
+package testbus
+
+// FakeBus is an in-memory adxl345.Bus backed by a 256-byte register
+// file, for exercising a Driver without real I2C/SPI hardware.
+type FakeBus struct {
+	Regs [256]byte
+}
+
+// New creates an empty FakeBus.
+func New() *FakeBus {
+	return &FakeBus{}
+}
+
+func (b *FakeBus) ReadReg(reg byte, p []byte) error {
+	for i := range p {
+		p[i] = b.Regs[int(reg)+i]
+	}
+	return nil
+}
+
+func (b *FakeBus) WriteReg(reg, val byte) error {
+	b.Regs[reg] = val
+	return nil
+}
+
+func (b *FakeBus) Close() error { return nil }
+
+// DataX1 is the ADXL345's DATAX1 register (0x33), mirroring the
+// unexported register of the same name in the root package.
+const DataX1 = 0x33
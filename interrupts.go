@@ -0,0 +1,218 @@
+package adxl345
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Interrupt-related registers
+const (
+	thresTap   = 0x1D
+	durReg     = 0x21
+	latentReg  = 0x22
+	windowReg  = 0x23
+	thresAct   = 0x24
+	thresInact = 0x25
+	timeInact  = 0x26
+	actInactCT = 0x27
+	thresFF    = 0x28
+	timeFF     = 0x29
+	tapAxes    = 0x2A
+	intEnable  = 0x2E
+	intMap     = 0x2F
+	intSource  = 0x30
+)
+
+// AxisMask selects which axes participate in activity and tap
+// detection.
+type AxisMask byte
+
+// Axis bits used by ConfigureActivity and the tap/activity control
+// registers.
+const (
+	AxisX AxisMask = 1 << 2
+	AxisY AxisMask = 1 << 1
+	AxisZ AxisMask = 1 << 0
+)
+
+// IntMask represents a set of ADXL345 interrupt bits, as found in
+// INT_ENABLE, INT_MAP and INT_SOURCE (registers 0x2E-0x30).
+type IntMask byte
+
+// Interrupt bits
+const (
+	IntOverrun   IntMask = 1 << 0
+	IntWatermark IntMask = 1 << 1
+	IntFreeFall  IntMask = 1 << 2
+	IntInactive  IntMask = 1 << 3
+	IntActive    IntMask = 1 << 4
+	IntDoubleTap IntMask = 1 << 5
+	IntSingleTap IntMask = 1 << 6
+	IntDataReady IntMask = 1 << 7
+)
+
+// scaleToTapThreshold converts a g value into the 62.5 mg/LSB
+// resolution used by THRESH_TAP, THRESH_ACT, THRESH_INACT and
+// THRESH_FF.
+func scaleToTapThreshold(g float64) byte {
+	return byte(round(g/0.0625, 0))
+}
+
+// scaleToTimeMS converts a duration in milliseconds into the
+// register resolution indicated, clamping to a single byte.
+func scaleToTimeMS(ms float64, resolutionMS float64) byte {
+	v := round(ms/resolutionMS, 0)
+	if v > 255 {
+		v = 255
+	}
+	return byte(v)
+}
+
+// ConfigureTap sets up the THRESH_TAP, DUR, LATENT, WINDOW and
+// TAP_AXES registers used for single/double tap detection. threshold
+// is in g (62.5 mg/LSB), duration, latent and window are in
+// milliseconds (duration is 625 us/LSB, latent and window are 1.25
+// ms/LSB). axes selects which of X/Y/Z participate in tap detection;
+// without at least one axis enabled in TAP_AXES the interrupt never
+// fires, regardless of INT_ENABLE.
+func (a ADXL345) ConfigureTap(threshold, duration, latent, window float64, axes AxisMask) error {
+	if err := a.bus.WriteReg(thresTap, scaleToTapThreshold(threshold)); err != nil {
+		return err
+	}
+	if err := a.bus.WriteReg(durReg, scaleToTimeMS(duration, 0.625)); err != nil {
+		return err
+	}
+	if err := a.bus.WriteReg(latentReg, scaleToTimeMS(latent, 1.25)); err != nil {
+		return err
+	}
+	if err := a.bus.WriteReg(windowReg, scaleToTimeMS(window, 1.25)); err != nil {
+		return err
+	}
+	return a.bus.WriteReg(tapAxes, byte(axes))
+}
+
+// ConfigureFreeFall sets up THRESH_FF and TIME_FF used for free-fall
+// detection. threshold is in g (62.5 mg/LSB), time is in
+// milliseconds (5 ms/LSB).
+func (a ADXL345) ConfigureFreeFall(threshold, time float64) error {
+	if err := a.bus.WriteReg(thresFF, scaleToTapThreshold(threshold)); err != nil {
+		return err
+	}
+	return a.bus.WriteReg(timeFF, scaleToTimeMS(time, 5))
+}
+
+// ConfigureActivity sets THRESH_ACT and enables the given axes in
+// ACT_INACT_CTL for AC-coupled activity detection. threshold is in g
+// (62.5 mg/LSB).
+func (a ADXL345) ConfigureActivity(threshold float64, axes AxisMask) error {
+	if err := a.bus.WriteReg(thresAct, scaleToTapThreshold(threshold)); err != nil {
+		return err
+	}
+
+	ctl, err := readByte(a.bus, actInactCT)
+	if err != nil {
+		return err
+	}
+
+	value := ctl&^0xF0 | 0x80 | (byte(axes) << 4)
+
+	return a.bus.WriteReg(actInactCT, value)
+}
+
+// MapInterrupts writes INT_MAP, routing every bit set in intPin2 to
+// the INT2 pin; every other bit (as INT_MAP's own 0-means-INT1
+// encoding already implies) goes to INT1, so intPin1 has no effect
+// on the written value beyond documenting the caller's intent.
+func (a ADXL345) MapInterrupts(intPin1, intPin2 IntMask) error {
+	return a.bus.WriteReg(intMap, byte(intPin2))
+}
+
+// EnableInterrupts enables the interrupts identified by mask in
+// INT_ENABLE.
+func (a ADXL345) EnableInterrupts(mask IntMask) error {
+	return a.bus.WriteReg(intEnable, byte(mask))
+}
+
+// ReadInterruptSource reads and clears INT_SOURCE, returning the set
+// of interrupts that fired.
+func (a ADXL345) ReadInterruptSource() (IntMask, error) {
+	retval, err := readByte(a.bus, intSource)
+	if err != nil {
+		return 0, err
+	}
+	return IntMask(retval), nil
+}
+
+// Event is a single decoded interrupt delivered by Events.
+type Event struct {
+	Source IntMask
+	Time   time.Time
+}
+
+// Events polls INT_SOURCE at the given interval and delivers a typed
+// Event on the returned channel whenever one or more interrupt bits
+// are set. The channel is closed when ctx is cancelled.
+func (a ADXL345) Events(ctx context.Context, pollInterval time.Duration) <-chan Event {
+	events := make(chan Event)
+
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				source, err := a.ReadInterruptSource()
+				if err != nil || source == 0 {
+					continue
+				}
+
+				select {
+				case events <- Event{Source: source, Time: time.Now()}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return events
+}
+
+// String renders the set interrupt bits by name, e.g. "SINGLE_TAP|DATA_READY".
+func (m IntMask) String() string {
+	names := []struct {
+		bit  IntMask
+		name string
+	}{
+		{IntOverrun, "OVERRUN"},
+		{IntWatermark, "WATERMARK"},
+		{IntFreeFall, "FREE_FALL"},
+		{IntInactive, "INACTIVITY"},
+		{IntActive, "ACTIVITY"},
+		{IntDoubleTap, "DOUBLE_TAP"},
+		{IntSingleTap, "SINGLE_TAP"},
+		{IntDataReady, "DATA_READY"},
+	}
+
+	out := ""
+	for _, n := range names {
+		if m&n.bit == 0 {
+			continue
+		}
+		if out != "" {
+			out += "|"
+		}
+		out += n.name
+	}
+
+	if out == "" {
+		return fmt.Sprintf("0x%02X", byte(m))
+	}
+	return out
+}
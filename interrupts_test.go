@@ -0,0 +1,78 @@
+package adxl345
+
+import "testing"
+
+func TestConfigureTapEnablesAxes(t *testing.T) {
+	bus := newFakeBus()
+	a, err := NewADXL345WithBus(bus, AddressDef)
+	if err != nil {
+		t.Fatalf("NewADXL345WithBus returned error: %v", err)
+	}
+
+	if err := a.ConfigureTap(2.0, 10, 80, 200, AxisX|AxisY|AxisZ); err != nil {
+		t.Fatalf("ConfigureTap returned error: %v", err)
+	}
+
+	if got, want := bus.regs[tapAxes], byte(AxisX|AxisY|AxisZ); got != want {
+		t.Errorf("TAP_AXES = 0x%02X, want 0x%02X", got, want)
+	}
+	if got := bus.regs[thresTap]; got != scaleToTapThreshold(2.0) {
+		t.Errorf("THRESH_TAP = 0x%02X, want 0x%02X", got, scaleToTapThreshold(2.0))
+	}
+}
+
+func TestConfigureActivityReplacesStaleAxes(t *testing.T) {
+	bus := newFakeBus()
+	a, err := NewADXL345WithBus(bus, AddressDef)
+	if err != nil {
+		t.Fatalf("NewADXL345WithBus returned error: %v", err)
+	}
+
+	if err := a.ConfigureActivity(1.0, AxisX|AxisY); err != nil {
+		t.Fatalf("ConfigureActivity returned error: %v", err)
+	}
+	if got, want := bus.regs[actInactCT], byte(0x80|(byte(AxisX|AxisY)<<4)); got != want {
+		t.Fatalf("ACT_INACT_CTL = 0x%02X, want 0x%02X", got, want)
+	}
+
+	if err := a.ConfigureActivity(1.0, AxisZ); err != nil {
+		t.Fatalf("ConfigureActivity returned error: %v", err)
+	}
+	if got, want := bus.regs[actInactCT], byte(0x80|(byte(AxisZ)<<4)); got != want {
+		t.Errorf("ACT_INACT_CTL after reconfiguring = 0x%02X, want 0x%02X (stale X/Y bits not cleared)", got, want)
+	}
+}
+
+func TestMapInterruptsOverlapRoutesToInt2(t *testing.T) {
+	bus := newFakeBus()
+	a, err := NewADXL345WithBus(bus, AddressDef)
+	if err != nil {
+		t.Fatalf("NewADXL345WithBus returned error: %v", err)
+	}
+
+	if err := a.MapInterrupts(IntSingleTap|IntDoubleTap, IntDoubleTap); err != nil {
+		t.Fatalf("MapInterrupts returned error: %v", err)
+	}
+
+	if got, want := bus.regs[intMap], byte(IntDoubleTap); got != want {
+		t.Errorf("INT_MAP = 0x%02X, want 0x%02X (bit present in both masks should route to INT2)", got, want)
+	}
+}
+
+func TestReadInterruptSource(t *testing.T) {
+	bus := newFakeBus()
+	a, err := NewADXL345WithBus(bus, AddressDef)
+	if err != nil {
+		t.Fatalf("NewADXL345WithBus returned error: %v", err)
+	}
+
+	bus.regs[intSource] = byte(IntSingleTap | IntDataReady)
+
+	source, err := a.ReadInterruptSource()
+	if err != nil {
+		t.Fatalf("ReadInterruptSource returned error: %v", err)
+	}
+	if source != IntSingleTap|IntDataReady {
+		t.Errorf("ReadInterruptSource() = %v, want %v", source, IntSingleTap|IntDataReady)
+	}
+}
@@ -0,0 +1,38 @@
+package adxl345
+
+import (
+	"periph.io/x/conn/v3/i2c"
+)
+
+// periphBus adapts a periph.io/x/conn/v3/i2c.Dev to the Bus
+// interface.
+type periphBus struct {
+	dev *i2c.Dev
+}
+
+// NewPeriphBus wraps an already-opened periph.io I2C device as a
+// Bus, for use with NewADXL345WithBus. The caller is responsible for
+// opening the underlying i2c.Bus (e.g. via periph's i2creg registry)
+// and for closing it once the Bus is no longer needed, since
+// periph.io's i2c.Dev has no Close method of its own.
+func NewPeriphBus(dev *i2c.Dev) Bus {
+	return periphBus{dev: dev}
+}
+
+// ReadReg reads len(p) bytes starting at reg, writing the register
+// address followed by reading the response in a single I2C
+// transaction.
+func (b periphBus) ReadReg(reg byte, p []byte) error {
+	return b.dev.Tx([]byte{reg}, p)
+}
+
+// WriteReg writes val to reg.
+func (b periphBus) WriteReg(reg, val byte) error {
+	return b.dev.Tx([]byte{reg, val}, nil)
+}
+
+// Close is a no-op: periph.io's i2c.Dev does not own the underlying
+// bus handle, so there is nothing for the ADXL345 driver to release.
+func (b periphBus) Close() error {
+	return nil
+}
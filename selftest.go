@@ -0,0 +1,135 @@
+package adxl345
+
+import (
+	"fmt"
+	"time"
+)
+
+// selfTestBit is bit 7 of DATA_FORMAT (0x31); setting it applies an
+// electrostatic force to the sensor element to verify it responds.
+const selfTestBit = 0x80
+
+// selfTestSettleDelay is how long the output is given to settle
+// after DATA_FORMAT is changed, before averaging begins.
+const selfTestSettleDelay = 10 * time.Millisecond
+
+// selfTestWindow describes the datasheet's expected self-test output
+// change for one axis, at the reference test conditions of Vs = 2.5 V
+// and ODR = 100 Hz. The change scales linearly with Vs; it does not
+// depend on ODR beyond needing at least 4 sample periods to settle
+// after SELF_TEST is toggled.
+type selfTestWindow struct {
+	min, max float64
+}
+
+// selfTestWindows holds the datasheet's per-axis min/max self-test
+// delta, in g, at Vs = 2.5 V.
+var selfTestWindows = struct {
+	X, Y, Z selfTestWindow
+}{
+	X: selfTestWindow{min: 0.60, max: 6.00},
+	Y: selfTestWindow{min: -6.00, max: -0.60},
+	Z: selfTestWindow{min: 0.30, max: 3.40},
+}
+
+// SelfTest runs the ADXL345's built-in electrostatic self-test: it
+// configures full-resolution +-16 g, averages samples with
+// SELF_TEST off and on, and compares the resulting per-axis delta
+// against the datasheet's expected window at Vs = 2.5 V. It restores
+// the original DATA_FORMAT before returning, whether or not the test
+// passes.
+func (a ADXL345) SelfTest() (delta Vector, pass bool, err error) {
+	const settleSamples = 10
+
+	rate, err := readByte(a.bus, bwRate)
+	if err != nil {
+		return Vector{}, false, err
+	}
+	settleAfterToggle := 4 * rateToInterval(rate)
+
+	original, err := readByte(a.bus, dataFormat)
+	if err != nil {
+		return Vector{}, false, err
+	}
+	defer func() {
+		if werr := a.bus.WriteReg(dataFormat, original); err == nil {
+			err = werr
+		}
+	}()
+
+	base := original&^byte(0x0F) | Range16G | 0x08
+
+	if err = a.bus.WriteReg(dataFormat, base&^byte(selfTestBit)); err != nil {
+		return Vector{}, false, err
+	}
+	time.Sleep(selfTestSettleDelay)
+	off, err := a.averageAxes(settleSamples)
+	if err != nil {
+		return Vector{}, false, err
+	}
+
+	if err = a.bus.WriteReg(dataFormat, base|selfTestBit); err != nil {
+		return Vector{}, false, err
+	}
+	time.Sleep(settleAfterToggle)
+	on, err := a.averageAxes(settleSamples)
+	if err != nil {
+		return Vector{}, false, err
+	}
+
+	delta = Vector{
+		X: round(on.X-off.X, 4),
+		Y: round(on.Y-off.Y, 4),
+		Z: round(on.Z-off.Z, 4),
+	}
+
+	axis, ok := firstOutOfWindow(delta)
+	if !ok {
+		return delta, true, nil
+	}
+
+	return delta, false, fmt.Errorf("adxl345: self-test failed on %s axis: delta %.4fg out of range", axis, axisValue(delta, axis))
+}
+
+// averageAxes reads n samples via GetAxesG and returns their mean.
+func (a ADXL345) averageAxes(n int) (Vector, error) {
+	var sum Vector
+	for i := 0; i < n; i++ {
+		v, err := a.GetAxesG()
+		if err != nil {
+			return Vector{}, err
+		}
+		sum.X += v.X
+		sum.Y += v.Y
+		sum.Z += v.Z
+	}
+
+	return Vector{X: sum.X / float64(n), Y: sum.Y / float64(n), Z: sum.Z / float64(n)}, nil
+}
+
+// firstOutOfWindow returns the name of the first axis whose delta
+// falls outside its datasheet window, if any.
+func firstOutOfWindow(delta Vector) (axis string, out bool) {
+	if delta.X < selfTestWindows.X.min || delta.X > selfTestWindows.X.max {
+		return "X", true
+	}
+	if delta.Y < selfTestWindows.Y.min || delta.Y > selfTestWindows.Y.max {
+		return "Y", true
+	}
+	if delta.Z < selfTestWindows.Z.min || delta.Z > selfTestWindows.Z.max {
+		return "Z", true
+	}
+	return "", false
+}
+
+// axisValue returns the delta component named by axis.
+func axisValue(delta Vector, axis string) float64 {
+	switch axis {
+	case "X":
+		return delta.X
+	case "Y":
+		return delta.Y
+	default:
+		return delta.Z
+	}
+}
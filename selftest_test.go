@@ -0,0 +1,26 @@
+package adxl345
+
+import "testing"
+
+func TestSelfTestFailsOutOfWindowAndRestoresDataFormat(t *testing.T) {
+	bus := newFakeBus()
+	a, err := NewADXL345WithBus(bus, AddressDef)
+	if err != nil {
+		t.Fatalf("NewADXL345WithBus returned error: %v", err)
+	}
+
+	// SelfTest reads bwRate itself to size its post-toggle settle
+	// wait; run it at the fastest ODR so the test stays quick while
+	// still exercising that read path.
+	if err := a.SetBandwidthRate(Rate1600HZ); err != nil {
+		t.Fatalf("SetBandwidthRate returned error: %v", err)
+	}
+
+	if _, _, err := a.SelfTest(); err == nil {
+		t.Fatal("SelfTest() expected an out-of-window error against a fakeBus reading 0g, got nil")
+	}
+
+	if got := bus.regs[dataFormat]; got != 0x08 {
+		t.Errorf("DATA_FORMAT after SelfTest = 0x%02X, want original 0x%02X restored", got, 0x08)
+	}
+}
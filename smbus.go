@@ -0,0 +1,50 @@
+package adxl345
+
+import (
+	"github.com/corrupt/go-smbus"
+)
+
+// smbusBus adapts *smbus.SMBus, the default transport used by
+// NewADXL345, to the Bus interface.
+type smbusBus struct {
+	smb *smbus.SMBus
+}
+
+// newSMBusBus opens the I2C bus at interfaceIdx for address and
+// wraps it as a Bus.
+func newSMBusBus(interfaceIdx uint, address byte) (Bus, error) {
+	smb, err := smbus.New(interfaceIdx, address)
+	if err != nil {
+		return nil, err
+	}
+	return smbusBus{smb: smb}, nil
+}
+
+// ReadReg reads len(p) bytes starting at reg. A single-byte read
+// goes through Read_byte_data, longer reads through
+// Read_i2c_block_data, matching the calls the driver used before the
+// Bus abstraction existed.
+func (b smbusBus) ReadReg(reg byte, p []byte) error {
+	if len(p) == 1 {
+		retval, err := b.smb.Read_byte_data(reg)
+		if err != nil {
+			return err
+		}
+		p[0] = byte(retval)
+		return nil
+	}
+
+	_, err := b.smb.Read_i2c_block_data(reg, p)
+	return err
+}
+
+// WriteReg writes val to reg.
+func (b smbusBus) WriteReg(reg, val byte) error {
+	return b.smb.Write_byte_data(reg, val)
+}
+
+// Close releases the underlying SMBus handle.
+func (b smbusBus) Close() error {
+	b.smb.Bus_close()
+	return nil
+}
@@ -0,0 +1,61 @@
+package adxl345
+
+// SPIDevice is the minimal full-duplex transfer operation an SPI
+// adapter needs. w and r are the same length; r receives whatever
+// was clocked in while w was clocked out. Implementations backed by
+// periph.io, golang.org/x/exp/io/spi or the Linux spidev ioctls all
+// satisfy this trivially.
+type SPIDevice interface {
+	Transfer(w, r []byte) error
+}
+
+// spiRead and spiMulti are the R/W and multi-byte (MB) bits the
+// ADXL345 expects in the first byte of every SPI transfer, per the
+// datasheet's SPI protocol.
+const (
+	spiRead  = 0x80
+	spiMulti = 0x40
+)
+
+// spiBus adapts an SPIDevice (3- or 4-wire, up to 5 MHz per the
+// datasheet) to the Bus interface.
+type spiBus struct {
+	dev SPIDevice
+}
+
+// NewSPIBus wraps an already-opened SPI device as a Bus, for use
+// with NewADXL345WithBus.
+func NewSPIBus(dev SPIDevice) Bus {
+	return spiBus{dev: dev}
+}
+
+// ReadReg reads len(p) bytes starting at reg, setting the R/W bit and,
+// for multi-byte reads, the MB bit in the leading command byte.
+func (b spiBus) ReadReg(reg byte, p []byte) error {
+	cmd := reg | spiRead
+	if len(p) > 1 {
+		cmd |= spiMulti
+	}
+
+	w := make([]byte, len(p)+1)
+	w[0] = cmd
+	r := make([]byte, len(p)+1)
+
+	if err := b.dev.Transfer(w, r); err != nil {
+		return err
+	}
+
+	copy(p, r[1:])
+	return nil
+}
+
+// WriteReg writes val to reg.
+func (b spiBus) WriteReg(reg, val byte) error {
+	w := []byte{reg, val}
+	return b.dev.Transfer(w, make([]byte, len(w)))
+}
+
+// Close is a no-op: the caller owns the SPI device handle.
+func (b spiBus) Close() error {
+	return nil
+}
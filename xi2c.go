@@ -0,0 +1,32 @@
+package adxl345
+
+import (
+	"golang.org/x/exp/io/i2c"
+)
+
+// xi2cBus adapts a golang.org/x/exp/io/i2c.Device to the Bus
+// interface.
+type xi2cBus struct {
+	dev *i2c.Device
+}
+
+// NewXI2CBus wraps an already-opened golang.org/x/exp/io/i2c.Device
+// as a Bus, for use with NewADXL345WithBus.
+func NewXI2CBus(dev *i2c.Device) Bus {
+	return xi2cBus{dev: dev}
+}
+
+// ReadReg reads len(p) bytes starting at reg.
+func (b xi2cBus) ReadReg(reg byte, p []byte) error {
+	return b.dev.ReadReg(reg, p)
+}
+
+// WriteReg writes val to reg.
+func (b xi2cBus) WriteReg(reg, val byte) error {
+	return b.dev.WriteReg(reg, []byte{val})
+}
+
+// Close releases the underlying device handle.
+func (b xi2cBus) Close() error {
+	return b.dev.Close()
+}